@@ -7,6 +7,10 @@ import (
 	"time"
 )
 
+// time for the nodes of a testnet to become ready after genesis. Nodes are
+// started before genesis, but beacon nodes may only report healthy once it passed.
+const nodeReadyGrace = time.Minute
+
 func jsonStr(v interface{}) string {
 	dat, err := json.MarshalIndent(v, "  ", "  ")
 	if err != nil {
@@ -70,6 +74,13 @@ func (nc *ClientDefinitionsByRole) SimpleTestnetTest() hivesim.TestSpec {
 			t.Logf("started all nodes!")
 
 			ctx := context.Background()
+			readyTimeout := nodeReadyGrace
+			if untilGenesis := genesisTime.Sub(time.Now()); untilGenesis > 0 {
+				readyTimeout += untilGenesis
+			}
+			testnet.WaitReady(ctx, readyTimeout)
+			t.Logf("all nodes are ready!")
+
 			// TODO: maybe run other assertions / tests in the background?
 			testnet.TrackFinality(ctx)
 		},
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/hive/hivesim"
 	"github.com/protolambda/eth2api"
 	"github.com/protolambda/eth2api/client/nodeapi"
@@ -22,6 +23,9 @@ const (
 	PortValidatorAPI = 5000
 )
 
+// interval between readiness checks of a node
+const readyPollInterval = time.Second
+
 // TODO: we assume the clients were configured with default ports.
 // Would be cleaner to run a script in the client to get the address without assumptions
 
@@ -38,6 +42,14 @@ func (en *Eth1Node) EngineRPCAddress() (string, error) {
 	return fmt.Sprintf("http://%v:%d", en.IP, PortEngineRPC), nil
 }
 
+// WaitReady polls the user RPC endpoint until the node reports its chain ID.
+func (en *Eth1Node) WaitReady(ctx context.Context) error {
+	return waitReady(ctx, func(ctx context.Context) error {
+		var chainID hexutil.Big
+		return en.RPC().CallContext(ctx, &chainID, "eth_chainId")
+	})
+}
+
 type BeaconNode struct {
 	*hivesim.Client
 	API *eth2api.Eth2HttpClient
@@ -65,6 +77,26 @@ func (bn *BeaconNode) ENR() (string, error) {
 	return out.ENR, nil
 }
 
+// WaitReady polls the health endpoint until the beacon node reports that it is
+// healthy (200) or syncing (206).
+func (bn *BeaconNode) WaitReady(ctx context.Context) error {
+	return waitReady(ctx, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, bn.API.Addr+"/eth/v1/node/health", nil)
+		if err != nil {
+			return err
+		}
+		resp, err := bn.API.Cli.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+			return fmt.Errorf("last status %d", resp.StatusCode)
+		}
+		return nil
+	})
+}
+
 func (bn *BeaconNode) EnodeURL() (string, error) {
 	return "", errors.New("beacon node does not have an discv4 Enode URL, use ENR or multi-address instead")
 }
@@ -72,3 +104,19 @@ func (bn *BeaconNode) EnodeURL() (string, error) {
 type ValidatorClient struct {
 	*hivesim.Client
 }
+
+// waitReady runs check until it succeeds. When the context expires first,
+// the error of the last check is returned.
+func waitReady(ctx context.Context, check func(context.Context) error) error {
+	for {
+		err := check(ctx)
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%v, last error: %v", ctx.Err(), err)
+		case <-time.After(readyPollInterval):
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/hive/hivesim"
+	"github.com/protolambda/eth2api"
+)
+
+// healthServer starts an HTTP server answering the health endpoint with the given status.
+func healthServer(t *testing.T, status int) *BeaconNode {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/eth/v1/node/health" {
+			http.NotFound(w, r)
+			return
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return &BeaconNode{
+		Client: &hivesim.Client{Type: "beacon"},
+		API: &eth2api.Eth2HttpClient{
+			Addr:  srv.URL,
+			Cli:   &http.Client{},
+			Codec: eth2api.JSONCodec{},
+		},
+	}
+}
+
+func TestWaitReadyRetries(t *testing.T) {
+	calls := 0
+	err := waitReady(context.Background(), func(context.Context) error {
+		calls++
+		if calls < 2 {
+			return errors.New("not yet")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if calls != 2 {
+		t.Errorf("wrong number of checks: got %d, want 2", calls)
+	}
+}
+
+func TestBeaconNodeWaitReady(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusPartialContent} {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		if err := healthServer(t, status).WaitReady(ctx); err != nil {
+			t.Errorf("node with health status %d not ready: %v", status, err)
+		}
+		cancel()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	err := healthServer(t, http.StatusServiceUnavailable).WaitReady(ctx)
+	if err == nil || !strings.Contains(err.Error(), "last status 503") {
+		t.Errorf("wrong error for unhealthy node: %v", err)
+	}
+}
+
+func TestTestnetWaitNodesReady(t *testing.T) {
+	testnet := &Testnet{
+		beacons: []*BeaconNode{
+			healthServer(t, http.StatusOK),
+			healthServer(t, http.StatusServiceUnavailable),
+			healthServer(t, http.StatusPartialContent),
+			healthServer(t, http.StatusInternalServerError),
+		},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	errs := testnet.waitNodesReady(ctx)
+	if len(errs) != 2 {
+		t.Fatalf("wrong number of errors: got %d, want 2: %v", len(errs), errs)
+	}
+	var msgs []string
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+	all := strings.Join(msgs, "\n")
+	for _, want := range []string{"[beacon 1] beacon never became ready", "last status 503", "[beacon 3] beacon never became ready", "last status 500"} {
+		if !strings.Contains(all, want) {
+			t.Errorf("errors do not contain %q:\n%s", want, all)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"github.com/ethereum/hive/hivesim"
 	"github.com/ethereum/hive/simulators/eth2/testnet/setup"
 	"github.com/protolambda/eth2api"
@@ -31,6 +32,50 @@ func (t *Testnet) GenesisTime() time.Time {
 	return time.Unix(int64(t.genesisTime), 0)
 }
 
+// WaitReady waits up to the given timeout for all eth1 and beacon nodes to
+// become ready. If any node is not ready in time, the test fails with an error
+// for each such node.
+func (t *Testnet) WaitReady(ctx context.Context, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	errs := t.waitNodesReady(ctx)
+	for _, err := range errs {
+		t.t.Error(err)
+	}
+	if len(errs) > 0 {
+		t.t.Fatalf("%d nodes did not become ready", len(errs))
+	}
+}
+
+// waitNodesReady checks all eth1 and beacon nodes concurrently and returns
+// an error for each node that is not ready before the context expires.
+func (t *Testnet) waitNodesReady(ctx context.Context) []error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	check := func(kind string, i int, name string, waitReady func(context.Context) error) {
+		defer wg.Done()
+		if err := waitReady(ctx); err != nil {
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("[%s %d] %s never became ready: %v", kind, i, name, err))
+			mu.Unlock()
+		}
+	}
+	for i, en := range t.eth1 {
+		wg.Add(1)
+		go check("eth1", i, en.Type, en.WaitReady)
+	}
+	for i, bn := range t.beacons {
+		wg.Add(1)
+		go check("beacon", i, bn.Type, bn.WaitReady)
+	}
+	wg.Wait()
+	return errs
+}
+
 func (t *Testnet) TrackFinality(ctx context.Context) {
 
 	genesis := t.GenesisTime()
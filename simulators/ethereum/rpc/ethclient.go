@@ -229,7 +229,10 @@ func balanceAndNonceAtTest(t *TestEnv) {
 // it against the genesis file to determine if block fields are
 // returned correct.
 func genesisHeaderByHashTest(t *TestEnv) {
-	gblock := loadGenesis()
+	gblock, err := loadGenesisBlock(t.Genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	headerByHash, err := t.Eth.HeaderByHash(t.Ctx(), gblock.Hash())
 	if err != nil {
@@ -244,7 +247,10 @@ func genesisHeaderByHashTest(t *TestEnv) {
 // it against the genesis file to determine if block fields are
 // returned correct.
 func genesisHeaderByNumberTest(t *TestEnv) {
-	gblock := loadGenesis()
+	gblock, err := loadGenesisBlock(t.Genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	headerByNum, err := t.Eth.HeaderByNumber(t.Ctx(), big0)
 	if err != nil {
@@ -258,7 +264,10 @@ func genesisHeaderByNumberTest(t *TestEnv) {
 // genesisBlockByHashTest fetched the known genesis block and compares it against
 // the genesis file to determine if block fields are returned correct.
 func genesisBlockByHashTest(t *TestEnv) {
-	gblock := loadGenesis()
+	gblock, err := loadGenesisBlock(t.Genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	blockByHash, err := t.Eth.BlockByHash(t.Ctx(), gblock.Hash())
 	if err != nil {
@@ -273,7 +282,10 @@ func genesisBlockByHashTest(t *TestEnv) {
 // that is known through the genesis.json file and tests if block
 // fields matches the fields defined in the genesis file.
 func genesisBlockByNumberTest(t *TestEnv) {
-	gblock := loadGenesis()
+	gblock, err := loadGenesisBlock(t.Genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	blockByNum, err := t.Eth.BlockByNumber(t.Ctx(), big0)
 	if err != nil {
@@ -3,10 +3,13 @@ package main
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -22,6 +25,9 @@ import (
 // default timeout for RPC calls
 var rpcTimeout = 10 * time.Second
 
+// default size limit for RPC bodies written to the test log
+const defaultMaxLogSize = 4 * 1024
+
 // TestClient is the environment of a single test.
 type TestEnv struct {
 	*hivesim.T
@@ -29,6 +35,9 @@ type TestEnv struct {
 	Eth   *ethclient.Client
 	Vault *vault
 
+	// Genesis is the path of the genesis file the client was started with.
+	Genesis string
+
 	// This holds most recent context created by the Ctx method.
 	// Every time Ctx is called, it creates a new context with the default
 	// timeout and cancels the previous one.
@@ -37,22 +46,26 @@ type TestEnv struct {
 }
 
 // runHTTP runs the given test function using the HTTP RPC client.
-func runHTTP(t *hivesim.T, c *hivesim.Client, v *vault, fn func(*TestEnv)) {
+// Request and response bodies larger than maxLogSize are summarized in the
+// test log. A maxLogSize of zero disables truncation.
+func runHTTP(t *hivesim.T, c *hivesim.Client, v *vault, maxLogSize int, fn func(*TestEnv)) {
 	// This sets up debug logging of the requests and responses.
 	client := &http.Client{
 		Transport: &loggingRoundTrip{
-			t:     t,
-			inner: http.DefaultTransport,
+			t:          t,
+			inner:      http.DefaultTransport,
+			maxLogSize: maxLogSize,
 		},
 	}
 
 	rpcClient, _ := rpc.DialHTTPWithClient(fmt.Sprintf("http://%v:8545/", c.IP), client)
 	defer rpcClient.Close()
 	env := &TestEnv{
-		T:     t,
-		RPC:   rpcClient,
-		Eth:   ethclient.NewClient(rpcClient),
-		Vault: v,
+		T:       t,
+		RPC:     rpcClient,
+		Eth:     ethclient.NewClient(rpcClient),
+		Vault:   v,
+		Genesis: genesisFileFor(genesisFile, c.Type),
 	}
 	fn(env)
 	if env.lastCtx != nil {
@@ -71,10 +84,11 @@ func runWS(t *hivesim.T, c *hivesim.Client, v *vault, fn func(*TestEnv)) {
 	defer rpcClient.Close()
 
 	env := &TestEnv{
-		T:     t,
-		RPC:   rpcClient,
-		Eth:   ethclient.NewClient(rpcClient),
-		Vault: v,
+		T:       t,
+		RPC:     rpcClient,
+		Eth:     ethclient.NewClient(rpcClient),
+		Vault:   v,
+		Genesis: genesisFileFor(genesisFile, c.Type),
 	}
 	fn(env)
 	if env.lastCtx != nil {
@@ -151,9 +165,12 @@ func waitForTxConfirmations(t *TestEnv, txHash common.Hash, n uint64) (*types.Re
 }
 
 // loggingRoundTrip writes requests and responses to the test log.
+// Only the logged text is affected by maxLogSize, the bodies passed on
+// to the client are always forwarded unchanged.
 type loggingRoundTrip struct {
-	t     *hivesim.T
-	inner http.RoundTripper
+	t          *hivesim.T
+	inner      http.RoundTripper
+	maxLogSize int
 }
 
 func (rt *loggingRoundTrip) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -163,7 +180,7 @@ func (rt *loggingRoundTrip) RoundTrip(req *http.Request) (*http.Response, error)
 	if err != nil {
 		return nil, err
 	}
-	rt.t.Logf(">>  %s", bytes.TrimSpace(reqBytes))
+	rt.logBody(">>", reqBytes)
 	reqCopy := *req
 	reqCopy.Body = ioutil.NopCloser(bytes.NewReader(reqBytes))
 
@@ -181,20 +198,89 @@ func (rt *loggingRoundTrip) RoundTrip(req *http.Request) (*http.Response, error)
 	}
 	respCopy := *resp
 	respCopy.Body = ioutil.NopCloser(bytes.NewReader(respBytes))
-	rt.t.Logf("<<  %s", bytes.TrimSpace(respBytes))
+	rt.logBody("<<", respBytes)
 	return &respCopy, nil
 }
 
-func loadGenesis() *types.Block {
-	contents, err := ioutil.ReadFile("init/genesis.json")
+// logBody writes a message body to the test log. Bodies exceeding the size
+// limit are replaced by a summary.
+func (rt *loggingRoundTrip) logBody(prefix string, body []byte) {
+	if rt.maxLogSize > 0 && len(body) > rt.maxLogSize {
+		rt.t.Logf("%s  %s", prefix, summarizeBody(body))
+		return
+	}
+	rt.t.Logf("%s  %s", prefix, bytes.TrimSpace(body))
+}
+
+// rpcMessage is the part of a JSON-RPC message used by summarizeBody.
+type rpcMessage struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+}
+
+// summarizeBody describes a JSON-RPC request or response by its size and hash,
+// along with the method name and params size for requests. The size and hash
+// are those of the body as sent, which allows correlating the summary with
+// captured traffic.
+func summarizeBody(body []byte) string {
+	summary := fmt.Sprintf("<%d bytes, sha256 %x>", len(body), sha256.Sum256(body))
+
+	var msgs []rpcMessage
+	if bytes.HasPrefix(bytes.TrimSpace(body), []byte("[")) {
+		if err := json.Unmarshal(body, &msgs); err != nil {
+			return summary
+		}
+		summary += fmt.Sprintf(" batch of %d", len(msgs))
+	} else {
+		var msg rpcMessage
+		if err := json.Unmarshal(body, &msg); err != nil {
+			return summary
+		}
+		msgs = append(msgs, msg)
+	}
+	for _, msg := range msgs {
+		switch {
+		case msg.Method != "":
+			summary += fmt.Sprintf(" %s(%d bytes params)", msg.Method, len(msg.Params))
+		case msg.Result != nil:
+			summary += fmt.Sprintf(" result(%d bytes)", len(msg.Result))
+		}
+	}
+	return summary
+}
+
+// genesisFileFor returns the genesis file for the given client type. If a
+// client-specific variant "<base>.<clienttype>.json" exists, it is used
+// instead of the base file.
+func genesisFileFor(base, clientType string) string {
+	variant := strings.TrimSuffix(base, ".json") + "." + clientType + ".json"
+	if _, err := os.Stat(variant); err == nil {
+		return variant
+	}
+	return base
+}
+
+// loadGenesis reads the genesis specification from the given file.
+func loadGenesis(path string) (*core.Genesis, error) {
+	contents, err := ioutil.ReadFile(path)
 	if err != nil {
-		panic(fmt.Errorf("can't to read genesis file: %v", err))
+		return nil, fmt.Errorf("can't read genesis file %s: %v", path, err)
 	}
 	var genesis core.Genesis
 	if err := json.Unmarshal(contents, &genesis); err != nil {
-		panic(fmt.Errorf("can't parse genesis JSON: %v", err))
+		return nil, fmt.Errorf("can't parse genesis JSON in %s: %v", path, err)
+	}
+	return &genesis, nil
+}
+
+// loadGenesisBlock returns the genesis block defined by the given genesis file.
+func loadGenesisBlock(path string) (*types.Block, error) {
+	genesis, err := loadGenesis(path)
+	if err != nil {
+		return nil, err
 	}
-	return genesis.ToBlock(nil)
+	return genesis.ToBlock(nil), nil
 }
 
 // diff checks whether x and y are deeply equal, returning a description
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/hive/hivesim"
+)
+
+func TestSummarizeBody(t *testing.T) {
+	tests := []struct {
+		body string
+		want string
+	}{
+		{
+			body: `{"jsonrpc":"2.0","id":1,"method":"eth_sendRawTransaction","params":["0x1234"]}`,
+			want: " eth_sendRawTransaction(10 bytes params)",
+		},
+		{
+			body: `[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`,
+			want: " batch of 2 eth_blockNumber(2 bytes params) eth_chainId(0 bytes params)",
+		},
+		{
+			body: "{\"jsonrpc\":\"2.0\",\"id\":1,\"result\":\"0x1234\"}\n",
+			want: " result(8 bytes)",
+		},
+		{
+			body: "not json",
+			want: "",
+		},
+	}
+	for _, test := range tests {
+		hash := sha256.Sum256([]byte(test.body))
+		want := fmt.Sprintf("<%d bytes, sha256 %x>%s", len(test.body), hash, test.want)
+		if got := summarizeBody([]byte(test.body)); got != want {
+			t.Errorf("wrong summary for %q\ngot:  %s\nwant: %s", test.body, got, want)
+		}
+	}
+}
+
+// This test checks that loggingRoundTrip forwards request and response bodies
+// unchanged when they exceed the log size limit.
+func TestLoggingRoundTripForwardsBody(t *testing.T) {
+	var (
+		reqBody  = `{"jsonrpc":"2.0","id":1,"method":"eth_sendRawTransaction","params":["0x` + strings.Repeat("ab", 1024) + `"]}`
+		respBody = `{"jsonrpc":"2.0","id":1,"result":"0x` + strings.Repeat("cd", 1024) + "\"}\n"
+		received []byte
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ = ioutil.ReadAll(r.Body)
+		// Flushing before the body is written makes the response chunked,
+		// i.e. it has no Content-Length.
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		w.Write([]byte(respBody))
+	}))
+	defer srv.Close()
+
+	client := &http.Client{
+		Transport: &loggingRoundTrip{
+			t:          &hivesim.T{},
+			inner:      http.DefaultTransport,
+			maxLogSize: 64,
+		},
+	}
+	resp, err := client.Post(srv.URL, "application/json", strings.NewReader(reqBody))
+	if err != nil {
+		t.Fatal("request failed:", err)
+	}
+	defer resp.Body.Close()
+	if resp.ContentLength != -1 {
+		t.Fatalf("response has Content-Length %d, want chunked response", resp.ContentLength)
+	}
+	got, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal("can't read response:", err)
+	}
+	if !bytes.Equal(received, []byte(reqBody)) {
+		t.Errorf("server received modified request body:\n%s", received)
+	}
+	if !bytes.Equal(got, []byte(respBody)) {
+		t.Errorf("client received modified response body:\n%s", got)
+	}
+}
+
+func TestLoadGenesisErrors(t *testing.T) {
+	dir := t.TempDir()
+	missing := filepath.Join(dir, "missing.json")
+	if _, err := loadGenesisBlock(missing); err == nil || !strings.Contains(err.Error(), missing) {
+		t.Errorf("wrong error for missing file: %v", err)
+	}
+	bad := filepath.Join(dir, "bad.json")
+	if err := ioutil.WriteFile(bad, []byte("{not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadGenesisBlock(bad); err == nil || !strings.Contains(err.Error(), bad) {
+		t.Errorf("wrong error for invalid JSON: %v", err)
+	}
+	if _, err := loadGenesisBlock(genesisFile); err != nil {
+		t.Errorf("can't load simulator genesis: %v", err)
+	}
+}
+
+func TestGenesisFileFor(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "genesis.json")
+	variant := filepath.Join(dir, "genesis.besu.json")
+	if err := ioutil.WriteFile(variant, []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if f := genesisFileFor(base, "besu"); f != variant {
+		t.Errorf("wrong genesis for client with variant: got %s, want %s", f, variant)
+	}
+	if f := genesisFileFor(base, "go-ethereum"); f != base {
+		t.Errorf("wrong genesis for client without variant: got %s, want %s", f, base)
+	}
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"math/big"
+	"os"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/params"
@@ -31,14 +32,25 @@ var clientEnv = hivesim.Params{
 	"HIVE_MINER":             "658bdf435d810c91414ec09147daa6db62406379",
 }
 
-var files = map[string]string{
-	"/genesis.json": "./init/genesis.json",
-}
+// genesisFile is the genesis used by the client and the tests. Client types
+// needing a different genesis can provide a variant, see genesisFileFor.
+const genesisFile = "./init/genesis.json"
 
 type testSpec struct {
 	Name  string
 	About string
 	Run   func(*TestEnv)
+
+	// FullLog disables the truncation of large RPC bodies in the test log.
+	FullLog bool
+}
+
+// maxLogSize returns the size limit for RPC bodies logged by the test.
+func (spec testSpec) maxLogSize() int {
+	if spec.FullLog {
+		return 0
+	}
+	return defaultMaxLogSize
 }
 
 var tests = []testSpec{
@@ -98,20 +110,34 @@ The RPC test suite runs a set of RPC related tests against a running node. It te
 several real-world scenarios such as sending value transactions, deploying a contract or
 interacting with one.`[1:],
 	}
-	suite.Add(&hivesim.ClientTestSpec{
-		Name:        "client launch",
-		Description: `This test launches the client and collects its logs.`,
-		Parameters:  clientEnv,
-		Files:       files,
-		Run:         runAllTests,
-	})
-	hivesim.MustRunSuite(hivesim.New(), suite)
+	// Every client type is started with its own genesis file, so the suite
+	// has one launch test per client type.
+	sim := hivesim.New()
+	clientTypes, err := sim.ClientTypes()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	for _, clientType := range clientTypes {
+		clientType := clientType
+		suite.Add(hivesim.TestSpec{
+			Name:        fmt.Sprintf("client launch (%s)", clientType),
+			Description: `This test launches the client and collects its logs.`,
+			Run: func(t *hivesim.T) {
+				files := map[string]string{
+					"/genesis.json": genesisFileFor(genesisFile, clientType),
+				}
+				runAllTests(t, t.StartClient(clientType, clientEnv, files))
+			},
+		})
+	}
+	hivesim.MustRunSuite(sim, suite)
 }
 
 // runAllTests runs the tests against a client instance.
 // Most tests simply wait for tx inclusion in a block so we can run many tests concurrently.
 func runAllTests(t *hivesim.T, c *hivesim.Client) {
-	vault := newVault()
+	vault := newVault(chainID)
 
 	s := newSemaphore(16)
 	for _, test := range tests {
@@ -125,7 +151,7 @@ func runAllTests(t *hivesim.T, c *hivesim.Client) {
 				Run: func(t *hivesim.T) {
 					switch test.Name[:strings.IndexByte(test.Name, '/')] {
 					case "http":
-						runHTTP(t, c, vault, test.Run)
+						runHTTP(t, c, vault, test.maxLogSize(), test.Run)
 					case "ws":
 						runWS(t, c, vault, test.Run)
 					default:
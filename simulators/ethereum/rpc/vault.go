@@ -33,6 +33,9 @@ var (
 // The purpose of the vault is allowing tests to run concurrently without worrying about
 // nonce assignment and unexpected balance changes.
 type vault struct {
+	// The chain ID used for signing transactions.
+	chainID *big.Int
+
 	mu sync.Mutex
 	// This tracks the account nonce of the vault account.
 	nonce uint64
@@ -40,8 +43,9 @@ type vault struct {
 	accounts map[common.Address]*ecdsa.PrivateKey
 }
 
-func newVault() *vault {
+func newVault(chainID *big.Int) *vault {
 	return &vault{
+		chainID:  chainID,
 		accounts: make(map[common.Address]*ecdsa.PrivateKey),
 	}
 }
@@ -50,7 +54,7 @@ func newVault() *vault {
 func (v *vault) generateKey() common.Address {
 	key, err := crypto.GenerateKey()
 	if err != nil {
-		panic(fmt.Errorf("can't generate account key:", err))
+		panic(fmt.Errorf("can't generate account key: %v", err))
 	}
 	addr := crypto.PubkeyToAddress(key.PublicKey)
 
@@ -67,15 +71,19 @@ func (v *vault) findKey(addr common.Address) *ecdsa.PrivateKey {
 	return v.accounts[addr]
 }
 
-// signTransaction signs the given transaction with the test account and returns it.
+// signer returns the transaction signer for the vault's chain.
 // It uses the EIP155 signing rules.
+func (v *vault) signer() types.Signer {
+	return types.NewEIP155Signer(v.chainID)
+}
+
+// signTransaction signs the given transaction with the test account and returns it.
 func (v *vault) signTransaction(sender common.Address, tx *types.Transaction) (*types.Transaction, error) {
 	key := v.findKey(sender)
 	if key == nil {
 		return nil, fmt.Errorf("sender account %v not in vault", sender)
 	}
-	signer := types.NewEIP155Signer(chainID)
-	return types.SignTx(tx, signer, key)
+	return types.SignTx(tx, v.signer(), key)
 }
 
 // createAndFundAccount creates a new account that is funded from the vault contract.
@@ -175,7 +183,7 @@ func (v *vault) createAccount(t *TestEnv, amount *big.Int) common.Address {
 
 	txBlock, err := t.Eth.BlockNumber(t.Ctx())
 	if err != nil {
-		t.Fatalf("can't get block number:", err)
+		t.Fatalf("can't get block number: %v", err)
 	}
 
 	// wait for vaultTxConfirmationCount confirmation by checking the balance vaultTxConfirmationCount blocks back.
@@ -183,7 +191,7 @@ func (v *vault) createAccount(t *TestEnv, amount *big.Int) common.Address {
 	for i := uint64(0); i < vaultTxConfirmationCount*4; i++ {
 		number, err := t.Eth.BlockNumber(t.Ctx())
 		if err != nil {
-			t.Fatalf("can't get block number:", err)
+			t.Fatalf("can't get block number: %v", err)
 		}
 		if number > txBlock+vaultTxConfirmationCount {
 			checkBlock := number - vaultTxConfirmationCount
@@ -212,8 +220,7 @@ func (v *vault) makeFundingTx(t *TestEnv, recipient common.Address, amount *big.
 		txAmount = new(big.Int)
 	)
 	tx := types.NewTransaction(nonce, predeployedVaultAddr, txAmount, gasLimit, gasPrice, payload)
-	signer := types.NewEIP155Signer(chainID)
-	signedTx, err := types.SignTx(tx, signer, vaultKey)
+	signedTx, err := types.SignTx(tx, v.signer(), vaultKey)
 	if err != nil {
 		t.Fatal("can't sign vault funding tx:", err)
 	}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// This test checks that the vault signs transactions for its configured chain ID.
+// The chain ID differs from the package-level default on purpose.
+func TestVaultSignTransaction(t *testing.T) {
+	vaultChainID := big.NewInt(1337)
+	v := newVault(vaultChainID)
+	sender := v.generateKey()
+
+	tx := types.NewTransaction(0, common.Address{0x01}, big.NewInt(1), 21000, gasPrice, nil)
+	signedTx, err := v.signTransaction(sender, tx)
+	if err != nil {
+		t.Fatal("can't sign transaction:", err)
+	}
+	if id := signedTx.ChainId(); id.Cmp(vaultChainID) != 0 {
+		t.Errorf("wrong chain ID in signed transaction: got %v, want %v", id, vaultChainID)
+	}
+	from, err := types.Sender(types.NewEIP155Signer(vaultChainID), signedTx)
+	if err != nil {
+		t.Fatal("can't recover sender:", err)
+	}
+	if from != sender {
+		t.Errorf("wrong sender: got %x, want %x", from, sender)
+	}
+	if _, err := types.Sender(types.NewEIP155Signer(chainID), signedTx); err == nil {
+		t.Errorf("transaction signed for chain ID %v verified under default chain ID %v", vaultChainID, chainID)
+	}
+}